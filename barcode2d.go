@@ -0,0 +1,152 @@
+package escpos
+
+import "fmt"
+
+// QRModel selects the QR Code symbol version family for PrintQRCode.
+// QRModel1 and QRModel2 carry the ASCII digits the select-model command
+// expects on the wire, not small integers.
+type QRModel byte
+
+const (
+	QRModel1 QRModel = '1'
+	QRModel2 QRModel = '2'
+	// QRModelMicro requests a Micro QR Code symbol. The select-model
+	// function only recognizes model 1 and model 2, so PrintQRCode
+	// rejects it explicitly rather than sending a meaningless model byte.
+	QRModelMicro QRModel = 'u'
+)
+
+// QRErrorCorrection selects the QR Code error-correction level.
+type QRErrorCorrection byte
+
+const (
+	QRErrorCorrectionL QRErrorCorrection = '0' + iota
+	QRErrorCorrectionM
+	QRErrorCorrectionQ
+	QRErrorCorrectionH
+)
+
+// QROptions configures PrintQRCode.
+type QROptions struct {
+	Model QRModel
+	// ModuleSize is the dot size per module, 1-16. Defaults to 3.
+	ModuleSize      int
+	ErrorCorrection QRErrorCorrection
+}
+
+// qrSymbolType is the cn byte identifying the QR Code symbol storage in
+// GS ( k.
+const qrSymbolType = 49
+
+// PrintQRCode stores data in the printer's QR Code symbol buffer and
+// prints it, following the GS ( k function 65 (select model), 67 (set
+// module size), 69 (set error correction level), 80 (store data), and
+// 81 (print stored data) command chain.
+func (p Printer) PrintQRCode(data string, opts QROptions) error {
+	model := opts.Model
+	if model == 0 {
+		model = QRModel2
+	}
+	if model == QRModelMicro {
+		return fmt.Errorf("escpos: Micro QR Code is not supported by the select-model command")
+	}
+	size := opts.ModuleSize
+	if size <= 0 {
+		size = 3
+	}
+	if size > 16 {
+		size = 16
+	}
+	ec := opts.ErrorCorrection
+	if ec == 0 {
+		ec = QRErrorCorrectionL
+	}
+
+	store := append([]byte{0x30}, []byte(data)...)
+	frames := [][]byte{
+		gsFuncK(qrSymbolType, 65, byte(model), 0x00),
+		gsFuncK(qrSymbolType, 67, byte(size)),
+		gsFuncK(qrSymbolType, 69, byte(ec)),
+		gsFuncK(qrSymbolType, 80, store...),
+		gsFuncK(qrSymbolType, 81, 0x30),
+	}
+
+	return writeFrames(p, "QR code", frames)
+}
+
+// PDF417Options configures PrintPDF417.
+type PDF417Options struct {
+	// Columns is the number of data columns, 0 lets the printer choose.
+	Columns int
+	// Rows is the number of rows, 0 lets the printer choose.
+	Rows int
+	// ModuleWidth and ModuleHeight are the module dot dimensions, 2-8 and
+	// 2-8 respectively. Both default to 3.
+	ModuleWidth  int
+	ModuleHeight int
+	// ErrorCorrectionLevel is 0-8; higher values add more redundancy.
+	ErrorCorrectionLevel int
+	// Truncated selects the truncated PDF417 variant, which omits the
+	// right-hand row indicator columns.
+	Truncated bool
+}
+
+// pdf417SymbolType is the cn byte identifying the PDF417 symbol storage in
+// GS ( k.
+const pdf417SymbolType = 48
+
+// PrintPDF417 stores data in the printer's PDF417 symbol buffer and prints
+// it, using functions 070-082 of GS ( k.
+func (p Printer) PrintPDF417(data string, opts PDF417Options) error {
+	width := opts.ModuleWidth
+	if width <= 0 {
+		width = 3
+	}
+	height := opts.ModuleHeight
+	if height <= 0 {
+		height = 3
+	}
+	truncated := byte(0)
+	if opts.Truncated {
+		truncated = 1
+	}
+
+	var frames [][]byte
+	if opts.Columns > 0 {
+		frames = append(frames, gsFuncK(pdf417SymbolType, 70, byte(opts.Columns)))
+	}
+	if opts.Rows > 0 {
+		frames = append(frames, gsFuncK(pdf417SymbolType, 71, byte(opts.Rows)))
+	}
+	frames = append(frames,
+		gsFuncK(pdf417SymbolType, 72, byte(width)),
+		gsFuncK(pdf417SymbolType, 73, byte(height)),
+		gsFuncK(pdf417SymbolType, 74, truncated),
+		gsFuncK(pdf417SymbolType, 75, 0x30, byte(opts.ErrorCorrectionLevel)),
+	)
+
+	store := append([]byte{0x30}, []byte(data)...)
+	frames = append(frames,
+		gsFuncK(pdf417SymbolType, 80, store...),
+		gsFuncK(pdf417SymbolType, 82, 0x30),
+	)
+
+	return writeFrames(p, "PDF417", frames)
+}
+
+// gsFuncK builds a GS ( k frame: GS ( k pL pH cn fn [parameters].
+func gsFuncK(cn byte, fn byte, params ...byte) []byte {
+	payload := append([]byte{cn, fn}, params...)
+	n := len(payload)
+	frame := []byte{0x1d, 0x28, 0x6b, byte(n & 0xff), byte((n >> 8) & 0xff)}
+	return append(frame, payload...)
+}
+
+func writeFrames(p Printer, what string, frames [][]byte) error {
+	for _, frame := range frames {
+		if _, err := p.Write(frame); err != nil {
+			return fmt.Errorf("escpos: writing %s command: %w", what, err)
+		}
+	}
+	return nil
+}
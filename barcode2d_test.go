@@ -0,0 +1,48 @@
+package escpos
+
+import (
+	"bytes"
+	"testing"
+)
+
+// fakeRWC is a minimal io.ReadWriteCloser that records every Write, for
+// asserting on the exact bytes a command builder sends.
+type fakeRWC struct {
+	bytes.Buffer
+}
+
+func (f *fakeRWC) Close() error { return nil }
+
+func TestGsFuncK(t *testing.T) {
+	got := gsFuncK(49, 65, 0x31, 0x00)
+	want := []byte{0x1d, 0x28, 0x6b, 0x04, 0x00, 49, 65, 0x31, 0x00}
+	if !bytes.Equal(got, want) {
+		t.Errorf("gsFuncK(49, 65, 0x31, 0x00) = % x, want % x", got, want)
+	}
+}
+
+func TestPrintQRCodeSendsASCIIModelByte(t *testing.T) {
+	rwc := &fakeRWC{}
+	p := Printer{ReadWriteCloser: rwc}
+
+	if err := p.PrintQRCode("hello", QROptions{Model: QRModel1}); err != nil {
+		t.Fatalf("PrintQRCode: %v", err)
+	}
+
+	out := rwc.Bytes()
+	// The select-model frame is GS ( k pL pH cn fn model zero: the model
+	// byte must be the ASCII digit '1' (0x31), not the small integer 1.
+	selectModel := []byte{0x1d, 0x28, 0x6b, 0x04, 0x00, qrSymbolType, 65, '1', 0x00}
+	if !bytes.Contains(out, selectModel) {
+		t.Errorf("select-model frame % x not found in output % x", selectModel, out)
+	}
+}
+
+func TestPrintQRCodeRejectsMicro(t *testing.T) {
+	rwc := &fakeRWC{}
+	p := Printer{ReadWriteCloser: rwc}
+
+	if err := p.PrintQRCode("hello", QROptions{Model: QRModelMicro}); err == nil {
+		t.Error("PrintQRCode with QRModelMicro: got nil error, want an error")
+	}
+}
@@ -0,0 +1,35 @@
+//go:build windows
+
+// Command epos-server bridges a browser-based POS UI to a Windows printer
+// by exposing it as an ePOS-Print SOAP endpoint.
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+
+	escpos "github.com/DevLumuz/go-escpos"
+	"github.com/DevLumuz/go-escpos/epos"
+)
+
+func main() {
+	printerName := flag.String("printer", "", "Windows printer name to bind")
+	addr := flag.String("addr", ":8008", "address to listen on")
+	flag.Parse()
+
+	if *printerName == "" {
+		log.Fatal("missing -printer")
+	}
+
+	printer, err := escpos.NewWindowsPrinter(*printerName)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer printer.Close()
+
+	http.Handle("/cgi-bin/epos/service.cgi", epos.NewServer(printer))
+
+	log.Printf("ePOS-Print server listening on %s, printing to %q", *addr, *printerName)
+	log.Fatal(http.ListenAndServe(*addr, nil))
+}
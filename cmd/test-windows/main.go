@@ -50,6 +50,12 @@ func main() {
 	}
 	defer printer.Close()
 
+	// Batch every test in this run into a single spool submission.
+	if _, err := printer.BeginJob("ESC/POS Test", escpos.JobOptions{Datatype: "RAW"}); err != nil {
+		log.Fatal(err)
+	}
+	defer printer.EndJob()
+
 	// Run tests
 	switch *testType {
 	case "receipt":
@@ -58,12 +64,16 @@ func main() {
 		testBarcode(printer)
 	case "format":
 		testFormatting(printer)
+	case "image":
+		testImage(printer)
 	case "all":
 		testReceipt(printer)
 		printer.FeedLines(2)
 		testBarcode(printer)
 		printer.FeedLines(2)
 		testFormatting(printer)
+		printer.FeedLines(2)
+		testImage(printer)
 	default:
 		log.Fatalf("Unknown test type: %s", *testType)
 	}
@@ -134,6 +144,43 @@ func testBarcode(printer escpos.Printer) {
 	printer.Cut()
 }
 
+func testImage(printer escpos.Printer) {
+	printer.Initialize()
+
+	printer.Justify(escpos.CenterJustify)
+	printer.SetBold(true)
+	printer.Println("IMAGE TEST")
+	printer.SetBold(false)
+	printer.LF()
+
+	printer.Println("QR Code:")
+	if err := printer.PrintQRCode("https://example.com/receipt/12345", escpos.QROptions{
+		Model:           escpos.QRModel2,
+		ModuleSize:      6,
+		ErrorCorrection: escpos.QRErrorCorrectionM,
+	}); err != nil {
+		log.Printf("QR code failed: %v", err)
+	}
+	printer.LF()
+
+	printer.Println("PDF417:")
+	if err := printer.PrintPDF417("ORDER-12345", escpos.PDF417Options{
+		ModuleWidth:  3,
+		ModuleHeight: 3,
+	}); err != nil {
+		log.Printf("PDF417 failed: %v", err)
+	}
+	printer.LF()
+
+	printer.Println("Stored logo:")
+	if err := printer.PrintNVImage(1); err != nil {
+		log.Printf("NV image failed: %v", err)
+	}
+
+	printer.FeedLines(3)
+	printer.Cut()
+}
+
 func testFormatting(printer escpos.Printer) {
 	printer.Initialize()
 
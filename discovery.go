@@ -0,0 +1,207 @@
+package escpos
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Backend identifies which transport a discovered printer can be opened with.
+type Backend string
+
+const (
+	BackendWindows Backend = "windows"
+	BackendCUPS    Backend = "cups"
+	BackendUSB     Backend = "usb"
+	BackendTCP     Backend = "tcp"
+)
+
+// PrinterInfo describes a printer found by Discover, independent of the
+// backend that will ultimately service it.
+type PrinterInfo struct {
+	Name         string
+	Backend      Backend
+	Location     string
+	Manufacturer string
+	Model        string
+	DeviceID     string
+	// IsESCPOS reports whether DeviceID's parsed CMD field advertises
+	// ESC/POS support, letting callers tell a receipt printer apart from
+	// other devices sharing the same USB class. Always false when DeviceID
+	// couldn't be probed.
+	IsESCPOS bool
+
+	// addr is backend-specific connection info (host:port for TCP, device
+	// path for USB) and is opaque to callers.
+	addr string
+}
+
+// DiscoverOptions controls which printers Discover returns.
+type DiscoverOptions struct {
+	// Include, when non-empty, keeps only printers whose name matches at
+	// least one of these regular expressions (a whitelist).
+	Include []string
+	// Exclude drops any printer whose name matches one of these regular
+	// expressions (a blacklist), applied after Include.
+	Exclude []string
+
+	// IgnoreRawPrinters skips queues that report no manufacturer, model, or
+	// device ID, which usually indicates a generic/virtual raw queue rather
+	// than a physical device.
+	IgnoreRawPrinters bool
+	// IgnoreClasses skips printers whose parsed IEEE-1284 CLS field matches
+	// any of these values (case-insensitive), e.g. "PRINTER", "MFP".
+	IgnoreClasses []string
+
+	// TCPHosts is a list of "host" or "host:port" addresses to probe as raw
+	// network printers. Port 9100 is assumed when omitted. Discover does not
+	// scan the network on its own; hosts must be supplied explicitly.
+	TCPHosts []string
+}
+
+// Discover enumerates printers from every backend available on the current
+// platform: the Windows spooler, CUPS, raw USB line printers, and any TCP
+// hosts supplied in opts.TCPHosts. The result is filtered according to opts
+// before being returned.
+func Discover(opts DiscoverOptions) ([]PrinterInfo, error) {
+	var all []PrinterInfo
+
+	platform, err := discoverPlatform()
+	if err != nil {
+		return nil, err
+	}
+	all = append(all, platform...)
+	all = append(all, discoverTCP(opts.TCPHosts)...)
+
+	include, err := compilePatterns(opts.Include)
+	if err != nil {
+		return nil, err
+	}
+	exclude, err := compilePatterns(opts.Exclude)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := all[:0]
+	for _, info := range all {
+		if opts.IgnoreRawPrinters && looksRaw(info) {
+			continue
+		}
+		if matchesAny(exclude, info.Name) {
+			continue
+		}
+		if len(include) > 0 && !matchesAny(include, info.Name) {
+			continue
+		}
+		if classIgnored(opts.IgnoreClasses, info) {
+			continue
+		}
+		filtered = append(filtered, info)
+	}
+
+	return filtered, nil
+}
+
+// Open dispatches to the backend-specific constructor for info.Backend.
+func Open(info PrinterInfo) (Printer, error) {
+	switch info.Backend {
+	case BackendWindows:
+		return NewWindowsPrinter(info.Name)
+	case BackendCUPS:
+		return NewCUPSPrinter(info.Name)
+	case BackendUSB:
+		return NewUSBPrinter(info.addr)
+	case BackendTCP:
+		return NewTCPPrinter(info.addr)
+	default:
+		return Printer{}, fmt.Errorf("escpos: unknown backend %q for printer %q", info.Backend, info.Name)
+	}
+}
+
+func discoverTCP(hosts []string) []PrinterInfo {
+	infos := make([]PrinterInfo, 0, len(hosts))
+	for _, host := range hosts {
+		addr := host
+		if !strings.Contains(addr, ":") {
+			addr = addr + ":9100"
+		}
+		infos = append(infos, PrinterInfo{
+			Name:    host,
+			Backend: BackendTCP,
+			addr:    addr,
+		})
+	}
+	return infos
+}
+
+func compilePatterns(patterns []string) ([]*regexp.Regexp, error) {
+	if len(patterns) == 0 {
+		return nil, nil
+	}
+	out := make([]*regexp.Regexp, 0, len(patterns))
+	for _, p := range patterns {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return nil, fmt.Errorf("escpos: invalid pattern %q: %w", p, err)
+		}
+		out = append(out, re)
+	}
+	return out, nil
+}
+
+func matchesAny(patterns []*regexp.Regexp, s string) bool {
+	for _, re := range patterns {
+		if re.MatchString(s) {
+			return true
+		}
+	}
+	return false
+}
+
+func looksRaw(info PrinterInfo) bool {
+	return info.Manufacturer == "" && info.Model == "" && info.DeviceID == ""
+}
+
+func classIgnored(classes []string, info PrinterInfo) bool {
+	if len(classes) == 0 || info.DeviceID == "" {
+		return false
+	}
+	cls := parseIEEE1284DeviceID(info.DeviceID)["CLS"]
+	if cls == "" {
+		return false
+	}
+	for _, c := range classes {
+		if strings.EqualFold(c, cls) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseIEEE1284DeviceID parses the "KEY:val,val;KEY:val;" structure returned
+// by IEEE-1284 device-ID probes into a map keyed by MFG, MDL, CMD, CLS, etc.
+// Trailing separators and surrounding whitespace are tolerated, since many
+// devices return a trimmed or slightly malformed string.
+func parseIEEE1284DeviceID(raw string) map[string]string {
+	fields := make(map[string]string)
+	for _, pair := range strings.Split(raw, ";") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		key, val, ok := strings.Cut(pair, ":")
+		if !ok {
+			continue
+		}
+		fields[strings.ToUpper(strings.TrimSpace(key))] = strings.TrimSpace(val)
+	}
+	return fields
+}
+
+// isESCPOSCommandSet reports whether a parsed IEEE-1284 CMD field advertises
+// ESC/POS support, so Discover callers can tell a receipt printer apart from
+// other devices sharing the same USB class.
+func isESCPOSCommandSet(fields map[string]string) bool {
+	cmd := strings.ToUpper(fields["CMD"])
+	return strings.Contains(cmd, "ESCPOS") || strings.Contains(cmd, "ESC/POS")
+}
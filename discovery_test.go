@@ -0,0 +1,78 @@
+package escpos
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseIEEE1284DeviceID(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want map[string]string
+	}{
+		{
+			name: "typical receipt printer",
+			raw:  "MFG:Epson;CMD:ESC/POS;MDL:TM-T88V;CLS:PRINTER;",
+			want: map[string]string{
+				"MFG": "Epson",
+				"CMD": "ESC/POS",
+				"MDL": "TM-T88V",
+				"CLS": "PRINTER",
+			},
+		},
+		{
+			name: "missing trailing separator and whitespace tolerated",
+			raw:  " MFG : Star ; MDL : TSP100 ",
+			want: map[string]string{
+				"MFG": "Star",
+				"MDL": "TSP100",
+			},
+		},
+		{
+			name: "empty",
+			raw:  "",
+			want: map[string]string{},
+		},
+		{
+			name: "malformed pair without colon is skipped",
+			raw:  "MFG:Epson;garbage;MDL:TM-T88V;",
+			want: map[string]string{
+				"MFG": "Epson",
+				"MDL": "TM-T88V",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseIEEE1284DeviceID(tt.raw)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseIEEE1284DeviceID(%q) = %v, want %v", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsESCPOSCommandSet(t *testing.T) {
+	tests := []struct {
+		name   string
+		fields map[string]string
+		want   bool
+	}{
+		{"exact ESCPOS", map[string]string{"CMD": "ESCPOS"}, true},
+		{"exact ESC/POS", map[string]string{"CMD": "ESC/POS"}, true},
+		{"lowercase", map[string]string{"CMD": "esc/pos"}, true},
+		{"among other command sets", map[string]string{"CMD": "PJL,PCL,ESC/POS"}, true},
+		{"unrelated command set", map[string]string{"CMD": "PJL,PCL5"}, false},
+		{"missing CMD field", map[string]string{"MFG": "Epson"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isESCPOSCommandSet(tt.fields); got != tt.want {
+				t.Errorf("isESCPOSCommandSet(%v) = %v, want %v", tt.fields, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+//go:build !windows
+
+package escpos
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"unsafe"
+)
+
+// ioctl direction/field shifts, as defined by asm-generic/ioctl.h.
+const (
+	iocNrShift   = 0
+	iocTypeShift = 8
+	iocSizeShift = 16
+	iocDirShift  = 30
+
+	iocRead = 2
+)
+
+func ioc(dir, typ, nr, size uintptr) uintptr {
+	return (dir << iocDirShift) | (typ << iocTypeShift) | (nr << iocNrShift) | (size << iocSizeShift)
+}
+
+// lpIOCGetDeviceID mirrors the Linux usblp printer driver's
+// LPIOC_GET_DEVICE_ID(len) macro: _IOC(_IOC_READ, 'P', 1, len).
+func lpIOCGetDeviceID(buflen int) uintptr {
+	return ioc(iocRead, uintptr('P'), 1, uintptr(buflen))
+}
+
+// discoverPlatform enumerates CUPS queues and raw USB line printers.
+func discoverPlatform() ([]PrinterInfo, error) {
+	var infos []PrinterInfo
+	infos = append(infos, discoverCUPS()...)
+	infos = append(infos, discoverUSB()...)
+	return infos, nil
+}
+
+// discoverCUPS shells out to "lpstat -a" to list configured queues. It
+// returns no error on failure (e.g. CUPS not installed) since the absence
+// of one backend shouldn't fail Discover as a whole.
+func discoverCUPS() []PrinterInfo {
+	out, err := exec.Command("lpstat", "-a").Output()
+	if err != nil {
+		return nil
+	}
+
+	var infos []PrinterInfo
+	for _, line := range strings.Split(string(out), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		name := strings.Fields(line)[0]
+		infos = append(infos, PrinterInfo{
+			Name:    name,
+			Backend: BackendCUPS,
+		})
+	}
+	return infos
+}
+
+// discoverUSB globs /dev/usb/lp* and probes each device's IEEE-1284 device
+// ID to fill in Manufacturer, Model, and DeviceID when possible.
+func discoverUSB() []PrinterInfo {
+	matches, err := filepath.Glob("/dev/usb/lp*")
+	if err != nil {
+		return nil
+	}
+
+	infos := make([]PrinterInfo, 0, len(matches))
+	for _, path := range matches {
+		info := PrinterInfo{
+			Name:    path,
+			Backend: BackendUSB,
+			addr:    path,
+		}
+
+		if deviceID, err := probeIEEE1284DeviceID(path); err == nil {
+			fields := parseIEEE1284DeviceID(deviceID)
+			info.DeviceID = deviceID
+			info.Manufacturer = fields["MFG"]
+			info.Model = fields["MDL"]
+			info.IsESCPOS = isESCPOSCommandSet(fields)
+			if name := strings.TrimSpace(fields["MFG"] + " " + fields["MDL"]); name != "" {
+				info.Name = name
+			}
+		}
+
+		infos = append(infos, info)
+	}
+	return infos
+}
+
+// probeIEEE1284DeviceID opens the given lp device and reads its IEEE-1284
+// device ID via the LPIOC_GET_DEVICE_ID ioctl: a big-endian 2-byte length
+// prefix followed by that many bytes of ASCII "KEY:val,val;KEY:val;" data.
+func probeIEEE1284DeviceID(path string) (string, error) {
+	f, err := os.OpenFile(path, os.O_RDWR, 0)
+	if err != nil {
+		return "", fmt.Errorf("escpos: opening %s: %w", path, err)
+	}
+	defer f.Close()
+
+	const bufLen = 1024
+	buf := make([]byte, bufLen)
+
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, f.Fd(), lpIOCGetDeviceID(bufLen), uintptr(unsafe.Pointer(&buf[0])))
+	if errno != 0 {
+		return "", fmt.Errorf("escpos: LPIOC_GET_DEVICE_ID on %s: %w", path, errno)
+	}
+
+	if len(buf) < 2 {
+		return "", fmt.Errorf("escpos: short device ID response from %s", path)
+	}
+	n := int(binary.BigEndian.Uint16(buf[:2]))
+	if n > len(buf) {
+		n = len(buf)
+	}
+	if n < 2 {
+		return "", fmt.Errorf("escpos: empty device ID response from %s", path)
+	}
+
+	// The length prefix is specified to include itself, but some devices
+	// report a trimmed length that excludes it; either is accepted here.
+	payload := bytes.TrimRight(buf[2:n], "\x00")
+	return string(payload), nil
+}
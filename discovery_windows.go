@@ -0,0 +1,37 @@
+//go:build windows
+
+package escpos
+
+import "fmt"
+
+// NewCUPSPrinter creates a new printer connection to a CUPS queue by name.
+// This function is only available on non-Windows systems.
+func NewCUPSPrinter(name string) (Printer, error) {
+	return Printer{}, fmt.Errorf("CUPS printer support is not available on this platform")
+}
+
+// NewUSBPrinter creates a new printer connection to a raw USB line printer
+// device. This function is only available on non-Windows systems.
+func NewUSBPrinter(path string) (Printer, error) {
+	return Printer{}, fmt.Errorf("raw USB printer support is not available on this platform")
+}
+
+// discoverPlatform enumerates printers known to the Windows spooler. It
+// returns no error if the spooler can't be reached (e.g. the service is
+// down), since the absence of one backend shouldn't fail Discover as a
+// whole, and shouldn't hide explicitly-configured TCPHosts either.
+func discoverPlatform() ([]PrinterInfo, error) {
+	names, err := GetInstalledPrinters()
+	if err != nil {
+		return nil, nil
+	}
+
+	infos := make([]PrinterInfo, 0, len(names))
+	for _, name := range names {
+		infos = append(infos, PrinterInfo{
+			Name:    name,
+			Backend: BackendWindows,
+		})
+	}
+	return infos, nil
+}
@@ -0,0 +1,56 @@
+package epos
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+
+	escpos "github.com/DevLumuz/go-escpos"
+)
+
+// envelope captures just enough of the SOAP envelope to reach the
+// epos-print body; namespace-qualified elements are matched by local name
+// only, since clients vary in which prefix they bind to the SOAP and
+// ePOS-Print namespaces.
+type envelope struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Body    struct {
+		EposPrint struct {
+			Inner []byte `xml:",innerxml"`
+		} `xml:"epos-print"`
+	} `xml:"Body"`
+}
+
+func parseEnvelope(r io.Reader) (*envelope, error) {
+	var env envelope
+	if err := xml.NewDecoder(r).Decode(&env); err != nil {
+		return nil, err
+	}
+	return &env, nil
+}
+
+// soapEnvelope renders the standard ePOS-Print response envelope.
+func soapEnvelope(success bool, code string, status escpos.Status) string {
+	return fmt.Sprintf(
+		`<?xml version="1.0" encoding="utf-8"?>`+
+			`<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/">`+
+			`<soap:Body><response success="%t" code="%s" status="%d"/></soap:Body>`+
+			`</soap:Envelope>`,
+		success, xmlEscapeAttr(code), status.Raw)
+}
+
+func firstError(errs ...error) string {
+	for _, err := range errs {
+		if err != nil {
+			return err.Error()
+		}
+	}
+	return ""
+}
+
+func xmlEscapeAttr(s string) string {
+	var buf bytes.Buffer
+	xml.EscapeText(&buf, []byte(s))
+	return buf.String()
+}
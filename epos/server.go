@@ -0,0 +1,361 @@
+// Package epos implements enough of Epson's ePOS-Print XML protocol to let
+// browser JavaScript running on a POS terminal print to a Go-managed
+// printer over HTTP, instead of requiring the Epson ePOS-Print SDK.
+package epos
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"image"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	escpos "github.com/DevLumuz/go-escpos"
+)
+
+// NewServer returns an http.Handler that accepts ePOS-Print SOAP requests
+// and renders them to printer.
+func NewServer(printer escpos.Printer) http.Handler {
+	return &handler{printer: printer}
+}
+
+type handler struct {
+	printer escpos.Printer
+
+	// mu serializes the BeginJob/render/Status/EndJob sequence: a single
+	// Printer is shared across every request, and its backends (e.g.
+	// WindowsPrinter's jobStarted/buffer state) aren't safe for concurrent
+	// use by two POS terminals printing at once.
+	mu sync.Mutex
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var renderErr error
+	var status escpos.Status
+
+	if _, err := h.printer.BeginJob("ePOS-Print job", escpos.JobOptions{Datatype: "RAW"}); err != nil {
+		renderErr = fmt.Errorf("epos: starting print job: %w", err)
+	} else {
+		renderErr = h.render(r.Body)
+
+		// Query status before EndJob: on spooler-mode Windows printers,
+		// Write (and so the underlying DLE EOT query) is refused once
+		// EndJob has run. A failed query is best-effort telemetry only and
+		// must not be folded into the print result reported as code -
+		// CUPS, for instance, answers it only through lpstat, which can fail.
+		if st, err := h.printer.Status(escpos.StatusPrinter); err == nil {
+			status = st
+		}
+
+		if err := h.printer.EndJob(); err != nil && renderErr == nil {
+			renderErr = fmt.Errorf("epos: ending print job: %w", err)
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/xml; charset=utf-8")
+	fmt.Fprint(w, soapEnvelope(renderErr == nil, firstError(renderErr), status))
+}
+
+// render walks the SOAP body in document order, translating each
+// epos-print element into calls on the underlying Printer.
+func (h *handler) render(body io.Reader) error {
+	env, err := parseEnvelope(body)
+	if err != nil {
+		return fmt.Errorf("epos: parsing request: %w", err)
+	}
+
+	dec := xml.NewDecoder(bytes.NewReader(env.Body.EposPrint.Inner))
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("epos: walking epos-print body: %w", err)
+		}
+
+		se, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		if err := h.dispatch(dec, se); err != nil {
+			return err
+		}
+	}
+}
+
+func (h *handler) dispatch(dec *xml.Decoder, se xml.StartElement) error {
+	switch se.Name.Local {
+	case "text":
+		return h.handleText(dec, se)
+	case "feed":
+		return h.handleFeed(dec, se)
+	case "cut":
+		return h.handleCut(dec, se)
+	case "barcode":
+		return h.handleBarcode(dec, se)
+	case "pulse":
+		return h.handlePulse(dec, se)
+	case "symbol":
+		return h.handleSymbol(dec, se)
+	case "image":
+		return h.handleImage(dec, se)
+	default:
+		return dec.Skip()
+	}
+}
+
+type textElement struct {
+	Align   string `xml:"align,attr"`
+	Width   int    `xml:"width,attr"`
+	Height  int    `xml:"height,attr"`
+	Em      bool   `xml:"em,attr"`
+	Smooth  bool   `xml:"smooth,attr"`
+	Reverse bool   `xml:"reverse,attr"`
+	Ul      bool   `xml:"ul,attr"`
+	Rotate  bool   `xml:"rotate,attr"`
+	Linespc int    `xml:"linespc,attr"`
+	Content string `xml:",chardata"`
+}
+
+func (h *handler) handleText(dec *xml.Decoder, se xml.StartElement) error {
+	var el textElement
+	if err := dec.DecodeElement(&el, &se); err != nil {
+		return fmt.Errorf("epos: decoding text element: %w", err)
+	}
+
+	if unsupported := unsupportedTextAttrs(el); len(unsupported) > 0 {
+		return fmt.Errorf("epos: unsupported text attribute(s): %s", strings.Join(unsupported, ", "))
+	}
+
+	if el.Align != "" {
+		h.printer.Justify(mapAlign(el.Align))
+	}
+	if el.Width > 0 || el.Height > 0 {
+		h.printer.SetCharacterSize(clampCharSize(el.Width), clampCharSize(el.Height))
+	}
+	h.printer.SetBold(el.Em)
+	h.printer.Println(el.Content)
+
+	return nil
+}
+
+// unsupportedTextAttrs reports which of the ePOS-Print text attributes el
+// requests have no equivalent on Printer's API (align/width/height/em are
+// the only ones wired up), so a request asking for them fails clearly
+// instead of being silently ignored.
+func unsupportedTextAttrs(el textElement) []string {
+	var unsupported []string
+	if el.Smooth {
+		unsupported = append(unsupported, "smooth")
+	}
+	if el.Reverse {
+		unsupported = append(unsupported, "reverse")
+	}
+	if el.Ul {
+		unsupported = append(unsupported, "ul")
+	}
+	if el.Rotate {
+		unsupported = append(unsupported, "rotate")
+	}
+	if el.Linespc > 0 {
+		unsupported = append(unsupported, "linespc")
+	}
+	return unsupported
+}
+
+type feedElement struct {
+	Line int `xml:"line,attr"`
+}
+
+func (h *handler) handleFeed(dec *xml.Decoder, se xml.StartElement) error {
+	var el feedElement
+	if err := dec.DecodeElement(&el, &se); err != nil {
+		return fmt.Errorf("epos: decoding feed element: %w", err)
+	}
+
+	lines := el.Line
+	if lines <= 0 {
+		lines = 1
+	}
+	h.printer.FeedLines(lines)
+	return nil
+}
+
+func (h *handler) handleCut(dec *xml.Decoder, se xml.StartElement) error {
+	if err := dec.Skip(); err != nil {
+		return fmt.Errorf("epos: decoding cut element: %w", err)
+	}
+	h.printer.Cut()
+	return nil
+}
+
+type barcodeElement struct {
+	Type    string `xml:"type,attr"`
+	Width   int    `xml:"width,attr"`
+	Height  int    `xml:"height,attr"`
+	Content string `xml:",chardata"`
+}
+
+func (h *handler) handleBarcode(dec *xml.Decoder, se xml.StartElement) error {
+	var el barcodeElement
+	if err := dec.DecodeElement(&el, &se); err != nil {
+		return fmt.Errorf("epos: decoding barcode element: %w", err)
+	}
+
+	bc, ok := mapBarcodeType(el.Type)
+	if !ok {
+		return fmt.Errorf("epos: unsupported barcode type %q", el.Type)
+	}
+	if el.Height > 0 {
+		h.printer.SetBarCodeHeight(el.Height)
+	}
+	h.printer.PrintBarCode(bc, el.Content)
+	return nil
+}
+
+type pulseElement struct {
+	// Drawer is "drawer_1" or "drawer_2", selecting the kick-out connector
+	// pin. Time is "pulse_100" or "pulse_200", the pulse on/off duration.
+	Drawer string `xml:"drawer,attr"`
+	Time   string `xml:"time,attr"`
+}
+
+// handlePulse drives the cash-drawer kickout connector with ESC p, the
+// standard ESC/POS generate-pulse command. It isn't part of Printer's
+// receipt-formatting API, so the bytes are written directly.
+func (h *handler) handlePulse(dec *xml.Decoder, se xml.StartElement) error {
+	var el pulseElement
+	if err := dec.DecodeElement(&el, &se); err != nil {
+		return fmt.Errorf("epos: decoding pulse element: %w", err)
+	}
+
+	pin := byte(0)
+	if el.Drawer == "drawer_2" {
+		pin = 1
+	}
+
+	t := pulseOnOffTime(el.Time)
+
+	_, err := h.printer.Write([]byte{0x1b, 0x70, pin, t, t})
+	return err
+}
+
+// pulseOnOffTime maps the ePOS-Print pulse duration enum ("pulse_100",
+// "pulse_200") to the ESC p on/off time parameter, in units of 2ms.
+func pulseOnOffTime(time string) byte {
+	switch time {
+	case "pulse_200":
+		return 100
+	default: // "pulse_100", or unset
+		return 50
+	}
+}
+
+type symbolElement struct {
+	Type    string `xml:"type,attr"`
+	Level   string `xml:"level,attr"`
+	Width   int    `xml:"width,attr"`
+	Content string `xml:",chardata"`
+}
+
+func (h *handler) handleSymbol(dec *xml.Decoder, se xml.StartElement) error {
+	var el symbolElement
+	if err := dec.DecodeElement(&el, &se); err != nil {
+		return fmt.Errorf("epos: decoding symbol element: %w", err)
+	}
+
+	switch {
+	case strings.HasPrefix(el.Type, "qrcode"):
+		return h.printer.PrintQRCode(el.Content, escpos.QROptions{
+			ModuleSize:      el.Width,
+			ErrorCorrection: mapQRErrorCorrection(el.Level),
+		})
+	case strings.HasPrefix(el.Type, "pdf417"):
+		return h.printer.PrintPDF417(el.Content, escpos.PDF417Options{
+			ModuleWidth: el.Width,
+		})
+	default:
+		return fmt.Errorf("epos: unsupported symbol type %q", el.Type)
+	}
+}
+
+func mapQRErrorCorrection(level string) escpos.QRErrorCorrection {
+	switch level {
+	case "level_m":
+		return escpos.QRErrorCorrectionM
+	case "level_q":
+		return escpos.QRErrorCorrectionQ
+	case "level_h":
+		return escpos.QRErrorCorrectionH
+	default:
+		return escpos.QRErrorCorrectionL
+	}
+}
+
+type imageElement struct {
+	Width   int    `xml:"width,attr"`
+	Height  int    `xml:"height,attr"`
+	Content string `xml:",chardata"`
+}
+
+func (h *handler) handleImage(dec *xml.Decoder, se xml.StartElement) error {
+	var el imageElement
+	if err := dec.DecodeElement(&el, &se); err != nil {
+		return fmt.Errorf("epos: decoding image element: %w", err)
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimSpace(el.Content))
+	if err != nil {
+		return fmt.Errorf("epos: decoding base64 image data: %w", err)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(raw))
+	if err != nil {
+		return fmt.Errorf("epos: decoding image data: %w", err)
+	}
+
+	return h.printer.PrintImage(img, escpos.ImageOptions{})
+}
+
+func mapAlign(align string) escpos.Justification {
+	switch align {
+	case "center":
+		return escpos.CenterJustify
+	case "right":
+		return escpos.RightJustify
+	default:
+		return escpos.LeftJustify
+	}
+}
+
+func clampCharSize(n int) int {
+	if n <= 0 {
+		return 0
+	}
+	if n > 7 {
+		return 7
+	}
+	return n - 1
+}
+
+func mapBarcodeType(t string) (escpos.BarCodeType, bool) {
+	switch t {
+	case "code39":
+		return escpos.BcCODE39, true
+	case "code128":
+		return escpos.BcCODE123, true
+	default:
+		return 0, false
+	}
+}
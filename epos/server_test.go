@@ -0,0 +1,105 @@
+package epos
+
+import (
+	"testing"
+
+	escpos "github.com/DevLumuz/go-escpos"
+)
+
+func TestMapAlign(t *testing.T) {
+	tests := []struct {
+		align string
+		want  escpos.Justification
+	}{
+		{"center", escpos.CenterJustify},
+		{"right", escpos.RightJustify},
+		{"left", escpos.LeftJustify},
+		{"", escpos.LeftJustify},
+		{"bogus", escpos.LeftJustify},
+	}
+	for _, tt := range tests {
+		if got := mapAlign(tt.align); got != tt.want {
+			t.Errorf("mapAlign(%q) = %v, want %v", tt.align, got, tt.want)
+		}
+	}
+}
+
+func TestMapQRErrorCorrection(t *testing.T) {
+	tests := []struct {
+		level string
+		want  escpos.QRErrorCorrection
+	}{
+		{"level_m", escpos.QRErrorCorrectionM},
+		{"level_q", escpos.QRErrorCorrectionQ},
+		{"level_h", escpos.QRErrorCorrectionH},
+		{"level_l", escpos.QRErrorCorrectionL},
+		{"", escpos.QRErrorCorrectionL},
+		{"m", escpos.QRErrorCorrectionL},
+	}
+	for _, tt := range tests {
+		if got := mapQRErrorCorrection(tt.level); got != tt.want {
+			t.Errorf("mapQRErrorCorrection(%q) = %v, want %v", tt.level, got, tt.want)
+		}
+	}
+}
+
+func TestPulseOnOffTime(t *testing.T) {
+	tests := []struct {
+		time string
+		want byte
+	}{
+		{"pulse_200", 100},
+		{"pulse_100", 50},
+		{"", 50},
+	}
+	for _, tt := range tests {
+		if got := pulseOnOffTime(tt.time); got != tt.want {
+			t.Errorf("pulseOnOffTime(%q) = %d, want %d", tt.time, got, tt.want)
+		}
+	}
+}
+
+func TestMapBarcodeType(t *testing.T) {
+	if _, ok := mapBarcodeType("bogus"); ok {
+		t.Error("mapBarcodeType(\"bogus\") ok = true, want false")
+	}
+	if bc, ok := mapBarcodeType("code128"); !ok || bc != escpos.BcCODE123 {
+		t.Errorf("mapBarcodeType(\"code128\") = %v, %v, want %v, true", bc, ok, escpos.BcCODE123)
+	}
+}
+
+func TestClampCharSize(t *testing.T) {
+	tests := []struct {
+		n    int
+		want int
+	}{
+		{0, 0},
+		{-1, 0},
+		{1, 0},
+		{8, 7},
+		{100, 7},
+	}
+	for _, tt := range tests {
+		if got := clampCharSize(tt.n); got != tt.want {
+			t.Errorf("clampCharSize(%d) = %d, want %d", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestUnsupportedTextAttrs(t *testing.T) {
+	if got := unsupportedTextAttrs(textElement{}); len(got) != 0 {
+		t.Errorf("unsupportedTextAttrs(zero value) = %v, want empty", got)
+	}
+
+	el := textElement{Smooth: true, Rotate: true, Linespc: 40}
+	got := unsupportedTextAttrs(el)
+	want := []string{"smooth", "rotate", "linespc"}
+	if len(got) != len(want) {
+		t.Fatalf("unsupportedTextAttrs(%+v) = %v, want %v", el, got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("unsupportedTextAttrs(%+v)[%d] = %q, want %q", el, i, got[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package escpos
+
+import (
+	"fmt"
+	"image"
+)
+
+// defaultImageChunkRows caps how many dot rows go into a single GS v 0
+// block so tall images don't overflow the printer's line buffer.
+const defaultImageChunkRows = 256
+
+// ImageOptions configures PrintImage.
+type ImageOptions struct {
+	// MaxChunkRows caps the dot rows sent per GS v 0 block. Defaults to
+	// defaultImageChunkRows when zero.
+	MaxChunkRows int
+}
+
+// PrintImage dithers img to 1-bpp with Floyd-Steinberg and prints it as one
+// or more GS v 0 raster blocks, splitting tall images into chunks to avoid
+// the printer's line buffer limits.
+func (p Printer) PrintImage(img image.Image, opts ImageOptions) error {
+	bitmap, width, height := ditherToBitmap(img)
+	return writeRasterBlocks(p, bitmap, width, height, opts.MaxChunkRows)
+}
+
+func writeRasterBlocks(p Printer, bitmap []byte, width, height, maxRows int) error {
+	if maxRows <= 0 {
+		maxRows = defaultImageChunkRows
+	}
+	widthBytes := (width + 7) / 8
+
+	for y := 0; y < height; y += maxRows {
+		rows := maxRows
+		if y+rows > height {
+			rows = height - y
+		}
+
+		header := []byte{
+			0x1d, 0x76, 0x30, 0x00,
+			byte(widthBytes & 0xff), byte((widthBytes >> 8) & 0xff),
+			byte(rows & 0xff), byte((rows >> 8) & 0xff),
+		}
+		if _, err := p.Write(header); err != nil {
+			return fmt.Errorf("escpos: writing raster header: %w", err)
+		}
+
+		start := y * widthBytes
+		end := start + rows*widthBytes
+		if _, err := p.Write(bitmap[start:end]); err != nil {
+			return fmt.Errorf("escpos: writing raster data: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// ditherToBitmap converts img to a 1-bpp, MSB-first, row-major bitmap using
+// Floyd-Steinberg dithering, matching the packing GS v 0 and FS q expect.
+// A set bit means the dot is printed (black).
+func ditherToBitmap(img image.Image) (bitmap []byte, width, height int) {
+	bounds := img.Bounds()
+	width, height = bounds.Dx(), bounds.Dy()
+
+	gray := make([][]float64, height)
+	for y := 0; y < height; y++ {
+		gray[y] = make([]float64, width)
+		for x := 0; x < width; x++ {
+			r, g, b, _ := img.At(bounds.Min.X+x, bounds.Min.Y+y).RGBA()
+			gray[y][x] = 0.299*float64(r>>8) + 0.587*float64(g>>8) + 0.114*float64(b>>8)
+		}
+	}
+
+	widthBytes := (width + 7) / 8
+	bitmap = make([]byte, widthBytes*height)
+
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			old := gray[y][x]
+			black := old < 128
+
+			var newVal float64
+			if black {
+				bitmap[y*widthBytes+x/8] |= 0x80 >> uint(x%8)
+			} else {
+				newVal = 255
+			}
+			quantErr := old - newVal
+
+			if x+1 < width {
+				gray[y][x+1] += quantErr * 7 / 16
+			}
+			if y+1 < height {
+				if x > 0 {
+					gray[y+1][x-1] += quantErr * 3 / 16
+				}
+				gray[y+1][x] += quantErr * 5 / 16
+				if x+1 < width {
+					gray[y+1][x+1] += quantErr * 1 / 16
+				}
+			}
+		}
+	}
+
+	return bitmap, width, height
+}
+
+// DefineNVImage stores img in the printer's non-volatile memory for later
+// recall with PrintNVImage, using FS q: "FS q n [xL xH yL yH d1...dk]"
+// repeated n times. FS q redefines the whole NV image table in a single
+// call and has no per-image id field - the images it carries are simply
+// numbered 1..n in the order they appear. Since this call only ever sends
+// one image, it can only define NV image 1.
+func (p Printer) DefineNVImage(id int, img image.Image) error {
+	if id != 1 {
+		return fmt.Errorf("escpos: DefineNVImage only supports id 1: FS q redefines the whole NV image table starting at image 1, not individual images by id")
+	}
+
+	bitmap, width, height := ditherToBitmap(img)
+	widthBytes := (width + 7) / 8
+
+	cmd := []byte{0x1c, 0x71, 0x01}
+	cmd = append(cmd, byte(widthBytes&0xff), byte((widthBytes>>8)&0xff))
+	cmd = append(cmd, byte(height&0xff), byte((height>>8)&0xff))
+	cmd = append(cmd, bitmap...)
+
+	if _, err := p.Write(cmd); err != nil {
+		return fmt.Errorf("escpos: defining NV image: %w", err)
+	}
+	return nil
+}
+
+// PrintNVImage prints the image previously stored under id with
+// DefineNVImage, using FS p.
+func (p Printer) PrintNVImage(id int) error {
+	cmd := []byte{0x1c, 0x70, byte(id), 0x00}
+	if _, err := p.Write(cmd); err != nil {
+		return fmt.Errorf("escpos: printing NV image %d: %w", id, err)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package escpos
+
+// JobID identifies a spool job, as assigned by BeginJob or reported by
+// ListJobs.
+type JobID int
+
+// JobOptions configures BeginJob.
+type JobOptions struct {
+	// Datatype selects how the spooler treats the submitted bytes: "RAW",
+	// "TEXT", or "XPS_PASS". Backends that don't distinguish datatypes
+	// ignore this.
+	Datatype string
+	// Priority is the job's scheduling priority, 1 (lowest) to 99
+	// (highest). Zero leaves the backend's default.
+	Priority int
+	// Notify is the username to notify on job completion or error, where
+	// the backend supports it.
+	Notify string
+}
+
+// JobInfo describes a queued or printing job, as reported by ListJobs.
+type JobInfo struct {
+	ID     JobID
+	Name   string
+	Status string
+	Pages  int
+}
+
+// jobController is implemented by backends with explicit multi-page,
+// multi-job control, such as WindowsPrinter.
+type jobController interface {
+	BeginJob(name string, opts JobOptions) (JobID, error)
+	EndJob() error
+	NewPage() error
+	ListJobs() ([]JobInfo, error)
+	CancelJob(id JobID) error
+}
+
+// BeginJob starts a new spool job, so callers can batch multiple receipts
+// into one submission instead of paying per-job overhead on every print -
+// important for label printers. On backends without explicit job control
+// (USB, TCP, CUPS), it's a no-op that always succeeds, since those
+// connections already behave as a single continuous stream.
+func (p Printer) BeginJob(name string, opts JobOptions) (JobID, error) {
+	if jc, ok := p.ReadWriteCloser.(jobController); ok {
+		return jc.BeginJob(name, opts)
+	}
+	return 0, nil
+}
+
+// EndJob finishes the job started by BeginJob. It is a no-op on backends
+// without explicit job control.
+func (p Printer) EndJob() error {
+	if jc, ok := p.ReadWriteCloser.(jobController); ok {
+		return jc.EndJob()
+	}
+	return nil
+}
+
+// NewPage starts a new page within the current job. It is a no-op on
+// backends without explicit job control.
+func (p Printer) NewPage() error {
+	if jc, ok := p.ReadWriteCloser.(jobController); ok {
+		return jc.NewPage()
+	}
+	return nil
+}
+
+// ListJobs reports jobs queued on the underlying backend. It returns an
+// empty list on backends without explicit job control.
+func (p Printer) ListJobs() ([]JobInfo, error) {
+	if jc, ok := p.ReadWriteCloser.(jobController); ok {
+		return jc.ListJobs()
+	}
+	return nil, nil
+}
+
+// CancelJob cancels a job previously reported by ListJobs. It is a no-op
+// on backends without explicit job control.
+func (p Printer) CancelJob(id JobID) error {
+	if jc, ok := p.ReadWriteCloser.(jobController); ok {
+		return jc.CancelJob(id)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+//go:build !windows
+
+package escpos
+
+import (
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+)
+
+// cupsPrinter submits raw bytes to a CUPS queue via "lp -d <name> -", one
+// print job per connection. CUPS has no bidirectional raw channel, so Read
+// is not supported; use Printer.Status, which falls back to lpstat parsing
+// on this backend.
+type cupsPrinter struct {
+	name  string
+	cmd   *exec.Cmd
+	stdin io.WriteCloser
+}
+
+// NewCUPSPrinter creates a new printer connection to a CUPS queue by name.
+func NewCUPSPrinter(name string) (Printer, error) {
+	cmd := exec.Command("lp", "-d", name, "-o", "raw", "-")
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return Printer{}, fmt.Errorf("escpos: preparing lp for %q: %w", name, err)
+	}
+	if err := cmd.Start(); err != nil {
+		return Printer{}, fmt.Errorf("escpos: starting lp for %q: %w", name, err)
+	}
+
+	return NewPrinter(&cupsPrinter{name: name, cmd: cmd, stdin: stdin}), nil
+}
+
+func (cp *cupsPrinter) Write(p []byte) (int, error) {
+	return cp.stdin.Write(p)
+}
+
+func (cp *cupsPrinter) Read(p []byte) (int, error) {
+	return 0, fmt.Errorf("escpos: read operation not supported on CUPS printers")
+}
+
+func (cp *cupsPrinter) Close() error {
+	if err := cp.stdin.Close(); err != nil {
+		return err
+	}
+	return cp.cmd.Wait()
+}
+
+// Status implements statusQuerier by shelling out to "lpstat -p <name>",
+// since CUPS queues have no DLE EOT channel to query directly. Only
+// StatusPrinter is meaningful over lpstat; other kinds report an error.
+func (cp *cupsPrinter) Status(kind StatusKind) (Status, error) {
+	if kind != StatusPrinter {
+		return Status{}, fmt.Errorf("escpos: status kind %d is not available on CUPS printers", kind)
+	}
+
+	out, err := exec.Command("lpstat", "-p", cp.name).Output()
+	if err != nil {
+		return Status{}, fmt.Errorf("escpos: querying lpstat for %q: %w", cp.name, err)
+	}
+
+	line := strings.ToLower(string(out))
+	st := Status{Online: true}
+	switch {
+	case strings.Contains(line, "disabled"):
+		st.Online = false
+		st.Errors = append(st.Errors, "printer disabled")
+	case strings.Contains(line, "now printing"):
+		st.Online = true
+	case strings.Contains(line, "idle"):
+		st.Online = true
+	default:
+		return Status{}, fmt.Errorf("escpos: unrecognized lpstat output for %q: %q", cp.name, strings.TrimSpace(string(out)))
+	}
+
+	return st, nil
+}
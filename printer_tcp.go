@@ -0,0 +1,21 @@
+package escpos
+
+import (
+	"fmt"
+	"net"
+	"time"
+)
+
+// tcpDialTimeout bounds how long NewTCPPrinter waits for the connection to
+// a network printer to establish.
+const tcpDialTimeout = 5 * time.Second
+
+// NewTCPPrinter creates a new printer connection to a network printer
+// listening on addr (host:port, typically port 9100).
+func NewTCPPrinter(addr string) (Printer, error) {
+	conn, err := net.DialTimeout("tcp", addr, tcpDialTimeout)
+	if err != nil {
+		return Printer{}, fmt.Errorf("escpos: dialing %s: %w", addr, err)
+	}
+	return NewPrinter(conn), nil
+}
@@ -0,0 +1,18 @@
+//go:build !windows
+
+package escpos
+
+import (
+	"fmt"
+	"os"
+)
+
+// NewUSBPrinter creates a new printer connection to a raw USB line printer
+// device, e.g. /dev/usb/lp0.
+func NewUSBPrinter(path string) (Printer, error) {
+	f, err := os.OpenFile(path, os.O_RDWR, 0)
+	if err != nil {
+		return Printer{}, fmt.Errorf("escpos: opening %s: %w", path, err)
+	}
+	return NewPrinter(f), nil
+}
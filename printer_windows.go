@@ -5,6 +5,7 @@ package escpos
 import (
 	"bytes"
 	"fmt"
+	"strings"
 	"syscall"
 	"unsafe"
 )
@@ -18,7 +19,25 @@ var (
 	procStartPagePrinter = winspool.NewProc("StartPagePrinter")
 	procEndPagePrinter   = winspool.NewProc("EndPagePrinter")
 	procWritePrinter     = winspool.NewProc("WritePrinter")
+	procReadPrinter      = winspool.NewProc("ReadPrinter")
 	procEnumPrintersW    = winspool.NewProc("EnumPrintersW")
+	procEnumJobs         = winspool.NewProc("EnumJobsW")
+	procSetJob           = winspool.NewProc("SetJobW")
+
+	kernel32        = syscall.NewLazyDLL("kernel32.dll")
+	procCreateFileW = kernel32.NewProc("CreateFileW")
+	procReadFile    = kernel32.NewProc("ReadFile")
+	procWriteFile   = kernel32.NewProc("WriteFile")
+	procCloseHandle = kernel32.NewProc("CloseHandle")
+)
+
+const (
+	genericRead    = 0x80000000
+	genericWrite   = 0x40000000
+	openExisting   = 3
+	fileShareRead  = 0x00000001
+	fileShareWrite = 0x00000002
+	invalidHandle  = ^uintptr(0)
 )
 
 const (
@@ -26,6 +45,19 @@ const (
 	PRINTER_ENUM_CONNECTIONS = 0x00000004
 )
 
+// SetJob dwCommand values, from winspool.h.
+const (
+	jobControlCancel = 3
+)
+
+// JOB_INFO_1 status bitmask values, from winspool.h.
+const (
+	jobStatusPaused   = 0x00000001
+	jobStatusError    = 0x00000002
+	jobStatusSpooling = 0x00000008
+	jobStatusPrinting = 0x00000010
+)
+
 type PRINTER_INFO_4 struct {
 	PrinterName *uint16
 	ServerName  *uint16
@@ -38,16 +70,50 @@ type DOC_INFO_1 struct {
 	Datatype   *uint16
 }
 
-// WindowsPrinter implements io.ReadWriteCloser for Windows printers
+// JOB_INFO_1 mirrors the Win32 struct of the same name; field order and
+// types must match exactly since EnumJobs/SetJob fill and read it in place.
+type JOB_INFO_1 struct {
+	JobId        uint32
+	pPrinterName *uint16
+	pMachineName *uint16
+	pUserName    *uint16
+	pDocument    *uint16
+	pDatatype    *uint16
+	pStatus      *uint16
+	Status       uint32
+	Priority     uint32
+	Position     uint32
+	TotalPages   uint32
+	PagesPrinted uint32
+	Submitted    syscall.Systemtime
+}
+
+// WindowsPrinter implements io.ReadWriteCloser for Windows printers.
+//
+// Two transports are supported. Spooled queues are opened with OpenPrinter
+// and written with WritePrinter/read with ReadPrinter; direct ports (LPT,
+// COM, or a USBPRINT device path) are opened with CreateFile and use
+// ReadFile/WriteFile instead, bypassing the spooler entirely for full-duplex
+// access to real-time status bytes.
 type WindowsPrinter struct {
 	name       string
-	handle     syscall.Handle
+	handle     syscall.Handle // spooler handle, set when using OpenPrinter
+	port       syscall.Handle // direct port handle, set when using CreateFile
 	buffer     bytes.Buffer
 	jobStarted bool
 }
 
-// NewWindowsPrinter creates a new printer connection to a Windows printer by name
+// NewWindowsPrinter creates a new printer connection to a Windows printer.
+// name may be a spooler queue name (e.g. "EPSON TM-T88V"), in which case
+// the connection goes through the spooler, or a direct device path such as
+// `\\.\LPT1`, `\\.\COM3`, or a USBPRINT path returned by
+// USBPrinterPortPaths, in which case it is opened directly for full-duplex
+// I/O.
 func NewWindowsPrinter(name string) (Printer, error) {
+	if isDevicePath(name) {
+		return newWindowsPortPrinter(name)
+	}
+
 	wp := &WindowsPrinter{
 		name: name,
 	}
@@ -67,53 +133,284 @@ func NewWindowsPrinter(name string) (Printer, error) {
 		return Printer{}, fmt.Errorf("failed to open printer %q: %w", name, err)
 	}
 
-	// Start document
-	if err := wp.startDoc(); err != nil {
-		wp.Close()
-		return Printer{}, err
+	// No job is started here: callers must call Printer.BeginJob before
+	// writing, which lets one connection batch several receipts into a
+	// single spool submission instead of paying per-job overhead for each.
+	return NewPrinter(wp), nil
+}
+
+// isDevicePath reports whether name looks like a Win32 device path rather
+// than a spooler queue name.
+func isDevicePath(name string) bool {
+	return strings.HasPrefix(name, `\\.\`) || strings.HasPrefix(name, `\\?\`)
+}
+
+// newWindowsPortPrinter opens name directly with CreateFile for
+// full-duplex Read/Write, bypassing the spooler.
+func newWindowsPortPrinter(name string) (Printer, error) {
+	namePtr, err := syscall.UTF16PtrFromString(name)
+	if err != nil {
+		return Printer{}, fmt.Errorf("invalid port path: %w", err)
+	}
+
+	ret, _, err := procCreateFileW.Call(
+		uintptr(unsafe.Pointer(namePtr)),
+		uintptr(genericRead|genericWrite),
+		uintptr(fileShareRead|fileShareWrite),
+		0,
+		uintptr(openExisting),
+		0,
+		0,
+	)
+	if ret == invalidHandle {
+		return Printer{}, fmt.Errorf("failed to open port %q: %w", name, err)
 	}
 
+	wp := &WindowsPrinter{
+		name: name,
+		port: syscall.Handle(ret),
+	}
 	return NewPrinter(wp), nil
 }
 
-func (wp *WindowsPrinter) startDoc() error {
-	docName, err := syscall.UTF16PtrFromString("ESC/POS Document")
+// BeginJob starts a spool job named name and returns its job ID. Write
+// fails until a job is active; EndJob (or Close) finishes it. A single
+// WindowsPrinter can run several BeginJob/EndJob cycles over its lifetime,
+// letting one connection submit multiple receipts as separate jobs, or
+// NewPage calls within one job for multi-page output.
+func (wp *WindowsPrinter) BeginJob(name string, opts JobOptions) (JobID, error) {
+	if err := wp.requireSpoolHandle(); err != nil {
+		return 0, err
+	}
+	if wp.jobStarted {
+		return 0, fmt.Errorf("a job is already active on this printer")
+	}
+
+	docNamePtr, err := syscall.UTF16PtrFromString(name)
 	if err != nil {
-		return fmt.Errorf("failed to create doc name: %w", err)
+		return 0, fmt.Errorf("failed to create doc name: %w", err)
 	}
 
-	datatype, err := syscall.UTF16PtrFromString("RAW")
+	datatype := opts.Datatype
+	if datatype == "" {
+		datatype = "RAW"
+	}
+	datatypePtr, err := syscall.UTF16PtrFromString(datatype)
 	if err != nil {
-		return fmt.Errorf("failed to create datatype: %w", err)
+		return 0, fmt.Errorf("failed to create datatype: %w", err)
 	}
 
 	docInfo := DOC_INFO_1{
-		DocName:    docName,
+		DocName:    docNamePtr,
 		OutputFile: nil,
-		Datatype:   datatype,
+		Datatype:   datatypePtr,
 	}
 
-	ret, _, err := procStartDocPrinterW.Call(
+	jobID, _, err := procStartDocPrinterW.Call(
 		uintptr(wp.handle),
 		1,
 		uintptr(unsafe.Pointer(&docInfo)),
 	)
-	if ret == 0 {
-		return fmt.Errorf("failed to start document: %w", err)
+	if jobID == 0 {
+		return 0, fmt.Errorf("failed to start document: %w", err)
+	}
+
+	if err := wp.startPage(); err != nil {
+		procEndDocPrinter.Call(uintptr(wp.handle))
+		return 0, err
+	}
+
+	// Mark the job active before applying options, so EndJob can finish
+	// the document if that fails.
+	wp.jobStarted = true
+
+	if opts.Priority != 0 || opts.Notify != "" {
+		if err := wp.setJobInfo(JobID(jobID), opts); err != nil {
+			wp.EndJob()
+			return 0, err
+		}
+	}
+
+	return JobID(jobID), nil
+}
+
+// requireSpoolHandle reports an error if wp was opened as a direct port
+// rather than through the spooler: job control (BeginJob/NewPage/EndJob)
+// relies on a spooler handle and has no meaning against a raw port.
+func (wp *WindowsPrinter) requireSpoolHandle() error {
+	if wp.handle == 0 {
+		return fmt.Errorf("escpos: job control is not available on direct port connections")
 	}
+	return nil
+}
 
-	// Start page
-	ret, _, err = procStartPagePrinter.Call(uintptr(wp.handle))
+func (wp *WindowsPrinter) startPage() error {
+	ret, _, err := procStartPagePrinter.Call(uintptr(wp.handle))
 	if ret == 0 {
 		return fmt.Errorf("failed to start page: %w", err)
 	}
+	return nil
+}
 
-	wp.jobStarted = true
+// NewPage ends the current page and starts a new one within the same job,
+// for multi-page jobs such as long labels.
+func (wp *WindowsPrinter) NewPage() error {
+	if err := wp.requireSpoolHandle(); err != nil {
+		return err
+	}
+	if !wp.jobStarted {
+		return fmt.Errorf("no job is active on this printer")
+	}
+
+	ret, _, err := procEndPagePrinter.Call(uintptr(wp.handle))
+	if ret == 0 {
+		return fmt.Errorf("failed to end page: %w", err)
+	}
+	return wp.startPage()
+}
+
+// EndJob finishes the job started by BeginJob.
+func (wp *WindowsPrinter) EndJob() error {
+	if err := wp.requireSpoolHandle(); err != nil {
+		return err
+	}
+	if !wp.jobStarted {
+		return fmt.Errorf("no job is active on this printer")
+	}
+
+	ret, _, err := procEndPagePrinter.Call(uintptr(wp.handle))
+	if ret == 0 {
+		return fmt.Errorf("failed to end page: %w", err)
+	}
+	ret, _, err = procEndDocPrinter.Call(uintptr(wp.handle))
+	if ret == 0 {
+		return fmt.Errorf("failed to end document: %w", err)
+	}
+
+	wp.jobStarted = false
+	return nil
+}
+
+// ListJobs reports jobs queued on this printer via EnumJobs.
+func (wp *WindowsPrinter) ListJobs() ([]JobInfo, error) {
+	var needed, returned uint32
+
+	procEnumJobs.Call(
+		uintptr(wp.handle),
+		0,
+		0xFFFFFFFF,
+		1, // JOB_INFO_1
+		0,
+		0,
+		uintptr(unsafe.Pointer(&needed)),
+		uintptr(unsafe.Pointer(&returned)),
+	)
+	if needed == 0 {
+		return nil, nil
+	}
+
+	buf := make([]byte, needed)
+	ret, _, err := procEnumJobs.Call(
+		uintptr(wp.handle),
+		0,
+		0xFFFFFFFF,
+		1, // JOB_INFO_1
+		uintptr(unsafe.Pointer(&buf[0])),
+		uintptr(needed),
+		uintptr(unsafe.Pointer(&needed)),
+		uintptr(unsafe.Pointer(&returned)),
+	)
+	if ret == 0 {
+		return nil, fmt.Errorf("failed to enumerate jobs: %w", err)
+	}
+
+	entries := (*[1 << 16]JOB_INFO_1)(unsafe.Pointer(&buf[0]))[:returned:returned]
+	jobs := make([]JobInfo, 0, returned)
+	for _, e := range entries {
+		info := JobInfo{ID: JobID(e.JobId)}
+		if e.pDocument != nil {
+			info.Name = syscall.UTF16ToString((*[1 << 10]uint16)(unsafe.Pointer(e.pDocument))[:])
+		}
+		info.Status = jobStatusString(e.Status)
+		info.Pages = int(e.TotalPages)
+		jobs = append(jobs, info)
+	}
+	return jobs, nil
+}
+
+// CancelJob cancels a previously queued job via SetJob(JOB_CONTROL_CANCEL).
+func (wp *WindowsPrinter) CancelJob(id JobID) error {
+	ret, _, err := procSetJob.Call(
+		uintptr(wp.handle),
+		uintptr(id),
+		0,
+		0,
+		uintptr(jobControlCancel),
+	)
+	if ret == 0 {
+		return fmt.Errorf("failed to cancel job %d: %w", id, err)
+	}
+	return nil
+}
+
+func (wp *WindowsPrinter) setJobInfo(id JobID, opts JobOptions) error {
+	var notifyPtr *uint16
+	if opts.Notify != "" {
+		ptr, err := syscall.UTF16PtrFromString(opts.Notify)
+		if err != nil {
+			return fmt.Errorf("failed to create notify username: %w", err)
+		}
+		notifyPtr = ptr
+	}
+
+	info := JOB_INFO_1{
+		JobId:     uint32(id),
+		pUserName: notifyPtr,
+		Priority:  uint32(opts.Priority),
+	}
+	ret, _, err := procSetJob.Call(
+		uintptr(wp.handle),
+		uintptr(id),
+		1, // JOB_INFO_1
+		uintptr(unsafe.Pointer(&info)),
+		0, // no job-control command; only apply the JOB_INFO_1 fields
+	)
+	if ret == 0 {
+		return fmt.Errorf("failed to set job options: %w", err)
+	}
 	return nil
 }
 
-// Write writes data to the printer
+func jobStatusString(status uint32) string {
+	switch {
+	case status&jobStatusPrinting != 0:
+		return "printing"
+	case status&jobStatusError != 0:
+		return "error"
+	case status&jobStatusPaused != 0:
+		return "paused"
+	case status&jobStatusSpooling != 0:
+		return "spooling"
+	default:
+		return "queued"
+	}
+}
+
+// Write writes data to the printer, either via WritePrinter against an
+// active spool job or directly to an open port.
 func (wp *WindowsPrinter) Write(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	if wp.port != 0 {
+		n, err := writeFile(wp.port, p)
+		if err == nil {
+			wp.buffer.Write(p[:n])
+		}
+		return n, err
+	}
+
 	if !wp.jobStarted {
 		return 0, fmt.Errorf("print job not started")
 	}
@@ -135,12 +432,36 @@ func (wp *WindowsPrinter) Write(p []byte) (int, error) {
 	return int(written), nil
 }
 
-// Read is not supported for Windows printers
+// Read reads a status reply from the printer. It is only supported when
+// the connection was opened directly as a port, or via ReadPrinter against
+// a spooler handle that exposes it.
 func (wp *WindowsPrinter) Read(p []byte) (int, error) {
-	return 0, fmt.Errorf("read operation not supported on Windows printers")
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	if wp.port != 0 {
+		return readFile(wp.port, p)
+	}
+
+	if wp.handle == 0 {
+		return 0, fmt.Errorf("read operation not supported on Windows printers")
+	}
+
+	var read uint32
+	ret, _, err := procReadPrinter.Call(
+		uintptr(wp.handle),
+		uintptr(unsafe.Pointer(&p[0])),
+		uintptr(len(p)),
+		uintptr(unsafe.Pointer(&read)),
+	)
+	if ret == 0 {
+		return 0, fmt.Errorf("failed to read from printer: %w", err)
+	}
+	return int(read), nil
 }
 
-// Close closes the printer connection
+// Close closes the printer connection.
 func (wp *WindowsPrinter) Close() error {
 	if wp.jobStarted {
 		procEndPagePrinter.Call(uintptr(wp.handle))
@@ -156,6 +477,14 @@ func (wp *WindowsPrinter) Close() error {
 		wp.handle = 0
 	}
 
+	if wp.port != 0 {
+		ret, _, err := procCloseHandle.Call(uintptr(wp.port))
+		if ret == 0 {
+			return fmt.Errorf("failed to close port: %w", err)
+		}
+		wp.port = 0
+	}
+
 	return nil
 }
 
@@ -164,6 +493,38 @@ func (wp *WindowsPrinter) Bytes() []byte {
 	return wp.buffer.Bytes()
 }
 
+// readFile performs a blocking ReadFile against an open port handle.
+func readFile(h syscall.Handle, p []byte) (int, error) {
+	var read uint32
+	ret, _, err := procReadFile.Call(
+		uintptr(h),
+		uintptr(unsafe.Pointer(&p[0])),
+		uintptr(len(p)),
+		uintptr(unsafe.Pointer(&read)),
+		0,
+	)
+	if ret == 0 {
+		return int(read), fmt.Errorf("failed to read from port: %w", err)
+	}
+	return int(read), nil
+}
+
+// writeFile performs a blocking WriteFile against an open port handle.
+func writeFile(h syscall.Handle, p []byte) (int, error) {
+	var written uint32
+	ret, _, err := procWriteFile.Call(
+		uintptr(h),
+		uintptr(unsafe.Pointer(&p[0])),
+		uintptr(len(p)),
+		uintptr(unsafe.Pointer(&written)),
+		0,
+	)
+	if ret == 0 {
+		return int(written), fmt.Errorf("failed to write to port: %w", err)
+	}
+	return int(written), nil
+}
+
 // GetInstalledPrinters returns a list of all installed printers on the system
 func GetInstalledPrinters() ([]string, error) {
 	flags := PRINTER_ENUM_LOCAL | PRINTER_ENUM_CONNECTIONS
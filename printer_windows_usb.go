@@ -0,0 +1,119 @@
+//go:build windows
+
+package escpos
+
+import (
+	"fmt"
+	"syscall"
+	"unsafe"
+)
+
+var (
+	setupapi                             = syscall.NewLazyDLL("setupapi.dll")
+	procSetupDiGetClassDevsW             = setupapi.NewProc("SetupDiGetClassDevsW")
+	procSetupDiEnumDeviceInterfaces      = setupapi.NewProc("SetupDiEnumDeviceInterfaces")
+	procSetupDiGetDeviceInterfaceDetailW = setupapi.NewProc("SetupDiGetDeviceInterfaceDetailW")
+	procSetupDiDestroyDeviceInfoList     = setupapi.NewProc("SetupDiDestroyDeviceInfoList")
+)
+
+type guid struct {
+	data1 uint32
+	data2 uint16
+	data3 uint16
+	data4 [8]byte
+}
+
+// guidDevInterfaceUSBPrint is GUID_DEVINTERFACE_USBPRINT
+// ({28d78fad-5a12-11d1-ae5b-0000f803a8c2}), the device interface class
+// exposed by Windows' usbprint driver for USB printers.
+var guidDevInterfaceUSBPrint = guid{0x28d78fad, 0x5a12, 0x11d1, [8]byte{0xae, 0x5b, 0x00, 0x00, 0xf8, 0x03, 0xa8, 0xc2}}
+
+const (
+	digcfPresent         = 0x00000002
+	digcfDeviceInterface = 0x00000010
+)
+
+type spDeviceInterfaceData struct {
+	cbSize             uint32
+	interfaceClassGUID guid
+	flags              uint32
+	reserved           uintptr
+}
+
+// USBPrinterPortPaths enumerates device interface paths for USB printers
+// exposed via GUID_DEVINTERFACE_USBPRINT. Each returned path can be passed
+// to NewWindowsPrinter for direct, full-duplex access, bypassing the
+// spooler.
+func USBPrinterPortPaths() ([]string, error) {
+	devInfo, _, err := procSetupDiGetClassDevsW.Call(
+		uintptr(unsafe.Pointer(&guidDevInterfaceUSBPrint)),
+		0,
+		0,
+		uintptr(digcfPresent|digcfDeviceInterface),
+	)
+	if devInfo == 0 || devInfo == invalidHandle {
+		return nil, fmt.Errorf("failed to enumerate USB printer interfaces: %w", err)
+	}
+	defer procSetupDiDestroyDeviceInfoList.Call(devInfo)
+
+	// SP_DEVICE_INTERFACE_DETAIL_DATA_W must be pre-stamped with its own
+	// cbSize, which (due to struct packing) differs between 32-bit and
+	// 64-bit builds even though the struct layout is otherwise identical.
+	detailSize := uint32(8)
+	if unsafe.Sizeof(uintptr(0)) == 4 {
+		detailSize = 6
+	}
+
+	var paths []string
+	for index := uint32(0); ; index++ {
+		var iface spDeviceInterfaceData
+		iface.cbSize = uint32(unsafe.Sizeof(iface))
+
+		ret, _, err := procSetupDiEnumDeviceInterfaces.Call(
+			devInfo,
+			0,
+			uintptr(unsafe.Pointer(&guidDevInterfaceUSBPrint)),
+			uintptr(index),
+			uintptr(unsafe.Pointer(&iface)),
+		)
+		if ret == 0 {
+			if err == syscall.ERROR_NO_MORE_ITEMS {
+				break
+			}
+			return nil, fmt.Errorf("failed to enumerate device interface %d: %w", index, err)
+		}
+
+		var needed uint32
+		procSetupDiGetDeviceInterfaceDetailW.Call(
+			devInfo,
+			uintptr(unsafe.Pointer(&iface)),
+			0,
+			0,
+			uintptr(unsafe.Pointer(&needed)),
+			0,
+		)
+		if needed == 0 {
+			continue
+		}
+
+		buf := make([]byte, needed)
+		*(*uint32)(unsafe.Pointer(&buf[0])) = detailSize
+
+		ret, _, err = procSetupDiGetDeviceInterfaceDetailW.Call(
+			devInfo,
+			uintptr(unsafe.Pointer(&iface)),
+			uintptr(unsafe.Pointer(&buf[0])),
+			uintptr(needed),
+			0,
+			0,
+		)
+		if ret == 0 {
+			return nil, fmt.Errorf("failed to get device interface detail for device %d: %w", index, err)
+		}
+
+		path := syscall.UTF16ToString((*[1 << 10]uint16)(unsafe.Pointer(&buf[4]))[:])
+		paths = append(paths, path)
+	}
+
+	return paths, nil
+}
@@ -0,0 +1,160 @@
+package escpos
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+)
+
+// StatusKind selects which real-time status transmission (DLE EOT n) to
+// send to the printer.
+type StatusKind byte
+
+const (
+	// StatusPrinter requests general printer status (DLE EOT 1).
+	StatusPrinter StatusKind = 1
+	// StatusOfflineCause requests the cause of an offline condition (DLE EOT 2).
+	StatusOfflineCause StatusKind = 2
+	// StatusErrorCause requests the cause of an error condition (DLE EOT 3).
+	StatusErrorCause StatusKind = 3
+	// StatusPaperSensor requests the roll paper sensor status (DLE EOT 4).
+	StatusPaperSensor StatusKind = 4
+)
+
+// statusPollInterval is how often WatchStatus polls the printer for a
+// general status update.
+const statusPollInterval = 500 * time.Millisecond
+
+// Status is the decoded result of a real-time status transmission.
+type Status struct {
+	Online      bool
+	PaperOut    bool
+	CoverOpen   bool
+	CutterError bool
+	Errors      []string
+	Raw         byte
+}
+
+// statusQuerier is implemented by backends that can't answer a DLE EOT
+// status query over their normal read/write channel and instead resolve
+// Status some other way, such as cupsPrinter's lpstat-based fallback.
+type statusQuerier interface {
+	Status(kind StatusKind) (Status, error)
+}
+
+// Status sends a real-time status transmission (DLE EOT n) for kind and
+// decodes the single-byte reply. It requires a backend capable of reading
+// from the printer; see WindowsPrinter's port-based Read path for Windows.
+// Backends without a bidirectional channel, such as cupsPrinter, answer
+// through their own statusQuerier implementation instead.
+func (p Printer) Status(kind StatusKind) (Status, error) {
+	if sq, ok := p.ReadWriteCloser.(statusQuerier); ok {
+		return sq.Status(kind)
+	}
+
+	if _, err := p.Write([]byte{0x10, 0x04, byte(kind)}); err != nil {
+		return Status{}, fmt.Errorf("escpos: sending status query: %w", err)
+	}
+
+	reply := make([]byte, 1)
+	if _, err := io.ReadFull(p, reply); err != nil {
+		return Status{}, fmt.Errorf("escpos: reading status reply: %w", err)
+	}
+
+	return decodeStatus(kind, reply[0]), nil
+}
+
+// WatchStatus polls general printer status at statusPollInterval, sending
+// each update on the returned channel until ctx is canceled, at which point
+// the channel is closed. A failed status query also stops the poller.
+func (p Printer) WatchStatus(ctx context.Context) (<-chan Status, error) {
+	ch := make(chan Status)
+
+	go func() {
+		defer close(ch)
+
+		ticker := time.NewTicker(statusPollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				st, err := p.Status(StatusPrinter)
+				if err != nil {
+					return
+				}
+				select {
+				case ch <- st:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// decodeStatus interprets a single status byte according to the meaning
+// assigned to it by kind.
+func decodeStatus(kind StatusKind, b byte) Status {
+	st := Status{Raw: b}
+
+	switch kind {
+	case StatusPrinter:
+		st.Online = b&0x08 == 0
+		st.Errors = decodeBitfieldErrors(b, [8]string{
+			2: "drawer kick-out connector pin 3 is high",
+			3: "offline",
+			5: "waiting for online recovery",
+			6: "paper feed by panel button",
+		})
+	case StatusOfflineCause:
+		st.CoverOpen = b&0x04 != 0
+		st.PaperOut = b&0x20 != 0
+		st.Errors = decodeBitfieldErrors(b, [8]string{
+			2: "cover open",
+			3: "paper feed button pressed",
+			5: "printing stopped due to paper end",
+			6: "error occurred",
+		})
+	case StatusErrorCause:
+		st.CutterError = b&0x08 != 0
+		st.Errors = decodeBitfieldErrors(b, [8]string{
+			2: "mechanical error",
+			3: "cutter error",
+			5: "unrecoverable error",
+			6: "auto-recoverable error",
+		})
+	case StatusPaperSensor:
+		st.PaperOut = b&0x60 != 0
+		st.Errors = decodeBitfieldErrors(b, [8]string{
+			2: "paper near end (sensor 1)",
+			3: "paper near end (sensor 2)",
+			5: "paper end (sensor 1)",
+			6: "paper end (sensor 2)",
+		})
+	}
+
+	return st
+}
+
+// decodeBitfieldErrors expands each set bit of b into the corresponding
+// human-readable label from labels (indexed LSB-first), analogous to the
+// Brother QL status code decoder. An empty label means that bit carries no
+// user-facing meaning and is skipped.
+func decodeBitfieldErrors(b byte, labels [8]string) []string {
+	var errs []string
+	for i := 0; i < 8; i++ {
+		if labels[i] == "" {
+			continue
+		}
+		if b&(1<<uint(i)) != 0 {
+			errs = append(errs, labels[i])
+		}
+	}
+	return errs
+}
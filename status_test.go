@@ -0,0 +1,104 @@
+package escpos
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDecodeStatusOfflineCause(t *testing.T) {
+	tests := []struct {
+		name         string
+		b            byte
+		wantCoverOpn bool
+		wantPaperOut bool
+		wantErrors   []string
+	}{
+		{
+			name:         "cover open only",
+			b:            0x04,
+			wantCoverOpn: true,
+			wantErrors:   []string{"cover open"},
+		},
+		{
+			name:         "stopped due to paper end",
+			b:            0x20,
+			wantPaperOut: true,
+			wantErrors:   []string{"printing stopped due to paper end"},
+		},
+		{
+			name:       "unrelated error does not imply paper out",
+			b:          0x40,
+			wantErrors: []string{"error occurred"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := decodeStatus(StatusOfflineCause, tt.b)
+			if st.CoverOpen != tt.wantCoverOpn {
+				t.Errorf("CoverOpen = %v, want %v", st.CoverOpen, tt.wantCoverOpn)
+			}
+			if st.PaperOut != tt.wantPaperOut {
+				t.Errorf("PaperOut = %v, want %v", st.PaperOut, tt.wantPaperOut)
+			}
+			if !reflect.DeepEqual(st.Errors, tt.wantErrors) {
+				t.Errorf("Errors = %v, want %v", st.Errors, tt.wantErrors)
+			}
+		})
+	}
+}
+
+func TestDecodeStatusErrorCause(t *testing.T) {
+	tests := []struct {
+		name          string
+		b             byte
+		wantCutterErr bool
+		wantErrors    []string
+	}{
+		{
+			name:       "unrecoverable error",
+			b:          0x20,
+			wantErrors: []string{"unrecoverable error"},
+		},
+		{
+			name:       "auto-recoverable error",
+			b:          0x40,
+			wantErrors: []string{"auto-recoverable error"},
+		},
+		{
+			name:          "cutter error",
+			b:             0x08,
+			wantCutterErr: true,
+			wantErrors:    []string{"cutter error"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := decodeStatus(StatusErrorCause, tt.b)
+			if st.CutterError != tt.wantCutterErr {
+				t.Errorf("CutterError = %v, want %v", st.CutterError, tt.wantCutterErr)
+			}
+			if !reflect.DeepEqual(st.Errors, tt.wantErrors) {
+				t.Errorf("Errors = %v, want %v", st.Errors, tt.wantErrors)
+			}
+		})
+	}
+}
+
+func TestDecodeBitfieldErrors(t *testing.T) {
+	labels := [8]string{
+		2: "bit two",
+		5: "bit five",
+	}
+
+	got := decodeBitfieldErrors(0x24, labels) // bits 2 and 5 set
+	want := []string{"bit two", "bit five"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("decodeBitfieldErrors(0x24, ...) = %v, want %v", got, want)
+	}
+
+	if got := decodeBitfieldErrors(0x00, labels); got != nil {
+		t.Errorf("decodeBitfieldErrors(0x00, ...) = %v, want nil", got)
+	}
+}